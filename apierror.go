@@ -0,0 +1,57 @@
+package twilio
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// APIError represents the JSON error body returned by the Twilio REST API on
+// a non-2xx response, e.g.:
+//
+//	{
+//	  "code": 20404,
+//	  "message": "The requested resource ... was not found",
+//	  "more_info": "https://www.twilio.com/docs/errors/20404",
+//	  "status": 404
+//	}
+type APIError struct {
+	// Status is the HTTP status code of the response.
+	Status int `json:"status"`
+	// Code is the Twilio-specific error code, see
+	// https://www.twilio.com/docs/api/errors.
+	Code int `json:"code"`
+	// Message is a human readable description of the error.
+	Message string `json:"message"`
+	// MoreInfo is a link to the Twilio documentation for this error code.
+	MoreInfo string `json:"more_info"`
+	// Details carries any additional, error-specific context Twilio attaches
+	// to the response.
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("twilio: %d: %s (more info: %s)", e.Code, e.Message, e.MoreInfo)
+}
+
+// Unwrap allows callers to keep using `errors.Is(err, twilio.ErrNotFound)`
+// once a 404 has been parsed into an *APIError.
+func (e *APIError) Unwrap() error {
+	if e.Status == http.StatusNotFound {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// parseAPIError attempts to decode body as a Twilio error response. It
+// returns nil if body isn't a recognizable error payload, in which case the
+// caller should fall back to a generic error.
+func parseAPIError(statusCode int, body []byte) *APIError {
+	var apiErr APIError
+	if err := json.Unmarshal(body, &apiErr); err != nil || apiErr.Message == "" {
+		return nil
+	}
+
+	apiErr.Status = statusCode
+	return &apiErr
+}
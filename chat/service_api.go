@@ -0,0 +1,114 @@
+package chat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// Service represents a Twilio Conversations/Chat service instance.
+type Service struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	FriendlyName string `json:"friendly_name"`
+	DateCreated  string `json:"date_created"`
+	DateUpdated  string `json:"date_updated"`
+	URL          string `json:"url"`
+}
+
+// ServiceUpdateParams are the fields accepted when creating or updating a
+// Service.
+type ServiceUpdateParams struct {
+	FriendlyName string `json:"friendly_name"`
+}
+
+// serviceListResponse is the shape of a single page returned by the
+// Services list endpoint.
+type serviceListResponse struct {
+	Services []Service       `json:"services"`
+	Meta     twilio.PageMeta `json:"meta"`
+}
+
+type serviceAPI struct {
+	client twilio.HTTPClient
+	// baseURL is the same base URL client was constructed with. List needs
+	// it to turn the absolute next_page_url Twilio returns back into a path
+	// relative to client, since client.Get prepends it again.
+	baseURL string
+}
+
+// Read fetches the Service identified by sid.
+func (s serviceAPI) Read(ctx context.Context, sid string) (Service, error) {
+	body, err := s.client.Get(ctx, "/Services/"+sid)
+	if err != nil {
+		return Service{}, err
+	}
+
+	var svc Service
+	if err := json.Unmarshal(body, &svc); err != nil {
+		return Service{}, err
+	}
+	return svc, nil
+}
+
+// Create provisions a new Service.
+func (s serviceAPI) Create(ctx context.Context, params ServiceUpdateParams) (Service, error) {
+	reqBody, err := json.Marshal(params)
+	if err != nil {
+		return Service{}, err
+	}
+
+	body, err := s.client.Post(ctx, "/Services", bytes.NewReader(reqBody))
+	if err != nil {
+		return Service{}, err
+	}
+
+	var svc Service
+	if err := json.Unmarshal(body, &svc); err != nil {
+		return Service{}, err
+	}
+	return svc, nil
+}
+
+// Update modifies the Service identified by sid.
+func (s serviceAPI) Update(ctx context.Context, sid string, params ServiceUpdateParams) (Service, error) {
+	reqBody, err := json.Marshal(params)
+	if err != nil {
+		return Service{}, err
+	}
+
+	body, err := s.client.Post(ctx, "/Services/"+sid, bytes.NewReader(reqBody))
+	if err != nil {
+		return Service{}, err
+	}
+
+	var svc Service
+	if err := json.Unmarshal(body, &svc); err != nil {
+		return Service{}, err
+	}
+	return svc, nil
+}
+
+// Delete removes the Service identified by sid.
+func (s serviceAPI) Delete(ctx context.Context, sid string) error {
+	_, err := s.client.Delete(ctx, "/Services/"+sid)
+	return err
+}
+
+// decodeServicePage turns a single Services list response body into a
+// twilio.Page[Service].
+func decodeServicePage(body []byte) (twilio.Page[Service], error) {
+	var resp serviceListResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return twilio.Page[Service]{}, err
+	}
+	return twilio.Page[Service]{Items: resp.Services, Meta: resp.Meta}, nil
+}
+
+// List returns an iterator over every Service, fetching pageSize items per
+// page and applying filter as query parameters on the initial request.
+func (s serviceAPI) List(pageSize int, filter map[string]string) *twilio.Iterator[Service] {
+	return twilio.NewIterator(s.client, s.baseURL, "/Services", pageSize, filter, decodeServicePage)
+}
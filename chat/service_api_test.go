@@ -21,12 +21,62 @@ var (
 	ctx   = context.Background()
 	setup = func() {
 		mockClient = &mockHTTPClient{}
-		srv = serviceAPI{mockClient}
+		srv = serviceAPI{client: mockClient, baseURL: "https://chat.twilio.com/v2"}
 	}
 )
 
 func TestServiceList(t *testing.T) {
+	t.Run("follows pagination across pages", func(t *testing.T) {
+		setup()
+
+		var gotPaths []string
+		mockClient.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			gotPaths = append(gotPaths, path)
+
+			switch path {
+			case "/Services?PageSize=1":
+				return ioutil.ReadFile("fixtures/services_page1.json")
+			case "/Services?PageSize=1&Page=1&PageToken=abc123":
+				return ioutil.ReadFile("fixtures/services_page2.json")
+			default:
+				t.Fatalf("unexpected request path %s", path)
+				return nil, nil
+			}
+		}
+
+		it := srv.List(1, nil)
+
+		var got []string
+		err := it.ForEach(ctx, func(svc Service) error {
+			got = append(got, svc.Sid)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		exp := []string{"ISaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "ISbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}
+		if !cmp.Equal(got, exp) {
+			t.Errorf("exp services %v, got %v", exp, got)
+		}
+		expPaths := []string{"/Services?PageSize=1", "/Services?PageSize=1&Page=1&PageToken=abc123"}
+		if !cmp.Equal(gotPaths, expPaths) {
+			t.Errorf("exp paths %v, got %v", expPaths, gotPaths)
+		}
+	})
+
+	t.Run("unsuccessful with parsing err", func(t *testing.T) {
+		setup()
+		mockClient.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			return ioutil.ReadFile("fixtures/invalid.json")
+		}
 
+		it := srv.List(1, nil)
+		_, _, err := it.Next(ctx)
+		if err == nil {
+			t.Errorf("exp parsing err, got %v", err)
+		}
+	})
 }
 
 func TestServiceRead(t *testing.T) {
@@ -81,6 +131,27 @@ func TestServiceRead(t *testing.T) {
 			t.Errorf("exp err %v, got %v", exp, got)
 		}
 	})
+
+	t.Run("unsuccessful with twilio api error", func(t *testing.T) {
+		setup()
+		apiErr := &twilio.APIError{Status: 404, Code: 20404, Message: "not found"}
+		mockClient.GetFunc = func(ctx context.Context, path string) ([]byte, error) {
+			return nil, apiErr
+		}
+
+		_, got := srv.Read(ctx, "SID")
+
+		var gotErr *twilio.APIError
+		if !errors.As(got, &gotErr) {
+			t.Fatalf("exp *twilio.APIError, got %T: %v", got, got)
+		}
+		if gotErr.Code != apiErr.Code {
+			t.Errorf("exp code %d, got %d", apiErr.Code, gotErr.Code)
+		}
+		if !errors.Is(got, twilio.ErrNotFound) {
+			t.Error("exp err to be twilio.ErrNotFound, got false")
+		}
+	})
 }
 
 func TestServiceCreate(t *testing.T) {
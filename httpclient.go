@@ -31,30 +31,45 @@ type apiClient struct {
 	url        *url.URL
 	accountSID string
 	authToken  string
+	retry      *RetryPolicy
 	RequestHandler
 }
 
 // NewHTTPClient returns a new twilio.Client which can be used to access various API
 // twilio rest apis. It requires a custom type `twilio.RequestHandler` which has the
-// method signature of the `http.Client` struct `Do` method.
-func NewHTTPClient(accountSID, authToken, baseURL string, rh RequestHandler) (HTTPClient, error) {
+// method signature of the `http.Client` struct `Do` method. Pass ClientOption
+// values to customize the client, e.g. WithMiddleware to stack behaviors such
+// as logging or tracing around rh.
+func NewHTTPClient(accountSID, authToken, baseURL string, rh RequestHandler, opts ...ClientOption) (HTTPClient, error) {
 	url, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not parse url")
 	}
 
-	return &apiClient{
+	client := &apiClient{
 		url:            url,
 		accountSID:     accountSID,
 		authToken:      authToken,
 		RequestHandler: rh,
-	}, nil
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client, nil
 }
 
 func (client *apiClient) Get(ctx context.Context, path string) ([]byte, error) {
 	return client.request(ctx, http.MethodGet, path, nil)
 }
 
+// Post issues a POST request. If a RetryPolicy is configured via
+// WithRetryPolicy, body is replayed on every retry attempt: pass an
+// io.ReadSeeker to have it rewound in place, otherwise it is buffered into
+// memory once up front. POSTs are only retried automatically when the
+// configured policy's RetryableMethods includes POST, or the caller opts in
+// per-request with WithIdempotent(ctx).
 func (client *apiClient) Post(ctx context.Context, path string, body io.Reader) ([]byte, error) {
 	return client.request(ctx, http.MethodPost, path, body)
 }
@@ -64,31 +79,103 @@ func (client *apiClient) Delete(ctx context.Context, path string) ([]byte, error
 }
 
 func (client *apiClient) request(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
-	req, err := http.NewRequest(method, client.url.String()+path, body)
+	newBody, err := bodyReplayer(body)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not create request")
+		return nil, errors.Wrap(err, "could not buffer request body")
 	}
 
-	{
-		req.SetBasicAuth(client.accountSID, client.authToken)
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-		req.WithContext(ctx)
+	attempts := 1
+	if policy := client.retry; policy != nil && policy.MaxAttempts > attempts {
+		attempts = policy.MaxAttempts
 	}
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, errors.Wrapf(err, "could not get a response for %s", req.URL)
-	}
-	defer resp.Body.Close()
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		req, err := http.NewRequest(method, client.url.String()+path, newBody())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create request")
+		}
+
+		{
+			req.SetBasicAuth(client.accountSID, client.authToken)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req = req.WithContext(ctx)
+		}
 
-	statusCode := resp.StatusCode
-	if statusCode < 200 || 400 <= statusCode {
-		if statusCode == http.StatusNotFound {
-			return nil, ErrNotFound
+		resp, doErr := client.Do(req)
+		if doErr != nil {
+			lastErr = errors.Wrapf(doErr, "could not get a response for %s", req.URL)
+
+			if !client.shouldRetry(ctx, req, attempt, attempts, 0) {
+				return nil, lastErr
+			}
+			if err := client.waitForRetry(ctx, attempt, lastErr, ""); err != nil {
+				return nil, err
+			}
+			continue
 		}
 
-		return nil, errors.Errorf("unexpected status code: %d", statusCode)
+		statusCode := resp.StatusCode
+		if statusCode < 200 || 400 <= statusCode {
+			respBody, _ := ioutil.ReadAll(resp.Body)
+			retryAfter := resp.Header.Get("Retry-After")
+			resp.Body.Close()
+
+			if apiErr := parseAPIError(statusCode, respBody); apiErr != nil {
+				lastErr = apiErr
+			} else if statusCode == http.StatusNotFound {
+				lastErr = ErrNotFound
+			} else {
+				lastErr = errors.Errorf("unexpected status code: %d", statusCode)
+			}
+
+			if !client.shouldRetry(ctx, req, attempt, attempts, statusCode) {
+				return nil, lastErr
+			}
+			if err := client.waitForRetry(ctx, attempt, lastErr, retryAfter); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		defer resp.Body.Close()
+		return ioutil.ReadAll(resp.Body)
+	}
+
+	return nil, lastErr
+}
+
+// shouldRetry reports whether another attempt should be made given the
+// client's RetryPolicy (if any), the outcome of the just-finished attempt,
+// and how many attempts remain.
+func (client *apiClient) shouldRetry(ctx context.Context, req *http.Request, attempt, attempts, statusCode int) bool {
+	policy := client.retry
+	if policy == nil || attempt >= attempts-1 {
+		return false
+	}
+	if !policy.retryableMethod(ctx, req.Method) {
+		return false
+	}
+	if statusCode != 0 && !policy.retryableStatus(statusCode) {
+		return false
+	}
+	return true
+}
+
+// waitForRetry sleeps for the delay computed by the RetryPolicy (honoring a
+// Retry-After header when present), invoking OnRetry first, and returns
+// ctx.Err() if ctx is cancelled before the sleep completes.
+func (client *apiClient) waitForRetry(ctx context.Context, attempt int, cause error, retryAfter string) error {
+	policy := client.retry
+
+	delay := policy.backoff(attempt)
+	if d, ok := parseRetryAfter(retryAfter); ok {
+		delay = d
+	}
+
+	if policy.OnRetry != nil {
+		policy.OnRetry(attempt, cause, delay)
 	}
 
-	return ioutil.ReadAll(resp.Body)
+	return sleepCtx(ctx, delay)
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -23,19 +24,19 @@ var (
 	acc     = "acc"
 	setup   = func() {
 		mockedRequestHandler = &mockRequestHandler{}
-		client, _ = NewClient(acc, auth, baseURL, mockedRequestHandler)
+		client, _ = NewHTTPClient(acc, auth, baseURL, mockedRequestHandler)
 	}
 )
 
-func TestNewClient(t *testing.T) {
+func TestNewHTTPClient(t *testing.T) {
 	t.Run("correct configuration", func(t *testing.T) {
-		if _, err := NewClient(acc, auth, baseURL, nil); err != nil {
+		if _, err := NewHTTPClient(acc, auth, baseURL, nil); err != nil {
 			t.Errorf("exp no err, got %v", err)
 		}
 	})
 
 	t.Run("invalid base URL", func(t *testing.T) {
-		if _, err := NewClient(acc, auth, "%", nil); err == nil {
+		if _, err := NewHTTPClient(acc, auth, "%", nil); err == nil {
 			t.Errorf("exp invalid URL parsing err, got %v", err)
 		}
 	})
@@ -131,6 +132,27 @@ func TestGet(t *testing.T) {
 		}
 	})
 
+	t.Run("unsuccessful request with twilio api error body", func(t *testing.T) {
+		setup()
+		mockedRequestHandler.requestHandlerFunc = func(r *http.Request) (*http.Response, error) {
+			body := ioutil.NopCloser(strings.NewReader(`{"code":20404,"message":"not found","more_info":"https://www.twilio.com/docs/errors/20404"}`))
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		}
+
+		_, err := client.Get(ctx, path)
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("exp *APIError, got %T: %v", err, err)
+		}
+		if exp := 20404; apiErr.Code != exp {
+			t.Errorf("exp code %d, got %d", exp, apiErr.Code)
+		}
+		if !errors.Is(err, ErrNotFound) {
+			t.Error("exp err to be ErrNotFound, got false")
+		}
+	})
+
 	t.Run("unsuccessful with invalid req url err", func(t *testing.T) {
 		setup()
 		path = "/get%2"
@@ -198,6 +220,179 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestWithMiddleware(t *testing.T) {
+	rh := &mockRequestHandler{
+		requestHandlerFunc: func(r *http.Request) (*http.Response, error) {
+			body := ioutil.NopCloser(strings.NewReader("{}"))
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next RequestHandler) RequestHandler {
+			return RequestHandlerFunc(func(r *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.Do(r)
+			})
+		}
+	}
+
+	c, err := NewHTTPClient(acc, auth, baseURL, rh, WithMiddleware(trace("inner"), trace("outer")))
+	if err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+
+	if _, err := c.Get(ctx, "/get"); err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+
+	if exp := []string{"outer", "inner"}; !cmp.Equal(order, exp) {
+		t.Errorf("exp middleware order %v, got %v", exp, order)
+	}
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	t.Run("retries a retryable status and succeeds", func(t *testing.T) {
+		var calls int
+		rh := &mockRequestHandler{
+			requestHandlerFunc: func(r *http.Request) (*http.Response, error) {
+				calls++
+				if calls < 3 {
+					body := ioutil.NopCloser(strings.NewReader("{}"))
+					return &http.Response{StatusCode: 503, Header: http.Header{}, Body: body}, nil
+				}
+				body := ioutil.NopCloser(strings.NewReader("{}"))
+				return &http.Response{StatusCode: 200, Body: body}, nil
+			},
+		}
+
+		var retries []int
+		policy := RetryPolicy{
+			MaxAttempts: 3,
+			BaseDelay:   time.Millisecond,
+			OnRetry: func(attempt int, err error, nextDelay time.Duration) {
+				retries = append(retries, attempt)
+			},
+		}
+
+		c, err := NewHTTPClient(acc, auth, baseURL, rh, WithRetryPolicy(policy))
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		if _, err := c.Get(ctx, "/get"); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp := 3; calls != exp {
+			t.Errorf("exp %d calls, got %d", exp, calls)
+		}
+		if exp := []int{0, 1}; !cmp.Equal(retries, exp) {
+			t.Errorf("exp OnRetry attempts %v, got %v", exp, retries)
+		}
+	})
+
+	t.Run("does not retry a non-idempotent POST by default", func(t *testing.T) {
+		var calls int
+		rh := &mockRequestHandler{
+			requestHandlerFunc: func(r *http.Request) (*http.Response, error) {
+				calls++
+				body := ioutil.NopCloser(strings.NewReader("{}"))
+				return &http.Response{StatusCode: 503, Header: http.Header{}, Body: body}, nil
+			},
+		}
+
+		policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+		c, _ := NewHTTPClient(acc, auth, baseURL, rh, WithRetryPolicy(policy))
+
+		if _, err := c.Post(ctx, "/post", strings.NewReader("{}")); err == nil {
+			t.Fatal("exp err, got none")
+		}
+		if exp := 1; calls != exp {
+			t.Errorf("exp %d call, got %d", exp, calls)
+		}
+	})
+
+	t.Run("retries a POST when caller opts in with WithIdempotent", func(t *testing.T) {
+		var calls int
+		var gotBodies [][]byte
+		rh := &mockRequestHandler{
+			requestHandlerFunc: func(r *http.Request) (*http.Response, error) {
+				calls++
+				b, _ := ioutil.ReadAll(r.Body)
+				gotBodies = append(gotBodies, b)
+				if calls < 2 {
+					body := ioutil.NopCloser(strings.NewReader("{}"))
+					return &http.Response{StatusCode: 500, Header: http.Header{}, Body: body}, nil
+				}
+				body := ioutil.NopCloser(strings.NewReader("{}"))
+				return &http.Response{StatusCode: 200, Body: body}, nil
+			},
+		}
+
+		policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+		c, _ := NewHTTPClient(acc, auth, baseURL, rh, WithRetryPolicy(policy))
+
+		if _, err := c.Post(WithIdempotent(ctx), "/post", strings.NewReader(`{"a":1}`)); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp := 2; calls != exp {
+			t.Errorf("exp %d calls, got %d", exp, calls)
+		}
+		for i, b := range gotBodies {
+			if exp := `{"a":1}`; string(b) != exp {
+				t.Errorf("call %d: exp replayed body %s, got %s", i, exp, b)
+			}
+		}
+	})
+
+	t.Run("honors Retry-After header", func(t *testing.T) {
+		var calls int
+		rh := &mockRequestHandler{
+			requestHandlerFunc: func(r *http.Request) (*http.Response, error) {
+				calls++
+				if calls < 2 {
+					header := http.Header{}
+					header.Set("Retry-After", "0")
+					body := ioutil.NopCloser(strings.NewReader("{}"))
+					return &http.Response{StatusCode: 429, Header: header, Body: body}, nil
+				}
+				body := ioutil.NopCloser(strings.NewReader("{}"))
+				return &http.Response{StatusCode: 200, Body: body}, nil
+			},
+		}
+
+		policy := RetryPolicy{MaxAttempts: 2, BaseDelay: time.Hour}
+		c, _ := NewHTTPClient(acc, auth, baseURL, rh, WithRetryPolicy(policy))
+
+		if _, err := c.Get(ctx, "/get"); err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if exp := 2; calls != exp {
+			t.Errorf("exp %d calls, got %d", exp, calls)
+		}
+	})
+
+	t.Run("aborts on context cancellation between attempts", func(t *testing.T) {
+		rh := &mockRequestHandler{
+			requestHandlerFunc: func(r *http.Request) (*http.Response, error) {
+				body := ioutil.NopCloser(strings.NewReader("{}"))
+				return &http.Response{StatusCode: 503, Header: http.Header{}, Body: body}, nil
+			},
+		}
+
+		policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour}
+		c, _ := NewHTTPClient(acc, auth, baseURL, rh, WithRetryPolicy(policy))
+
+		cancelCtx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if _, err := c.Get(cancelCtx, "/get"); !errors.Is(err, context.Canceled) {
+			t.Errorf("exp context.Canceled, got %v", err)
+		}
+	})
+}
+
 type mockRequestHandler struct {
 	requestInvoked     bool
 	requestHandlerFunc func(*http.Request) (*http.Response, error)
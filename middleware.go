@@ -0,0 +1,54 @@
+package twilio
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// RequestHandlerFunc adapts a plain function to a RequestHandler, mirroring
+// `http.HandlerFunc`.
+type RequestHandlerFunc func(*http.Request) (*http.Response, error)
+
+// Do calls f(r).
+func (f RequestHandlerFunc) Do(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// Middleware wraps a RequestHandler with additional behavior (logging,
+// metrics, tracing spans, request-id injection, auth token refresh, ...)
+// before delegating to next.
+type Middleware func(next RequestHandler) RequestHandler
+
+// ClientOption configures an apiClient constructed by NewHTTPClient.
+type ClientOption func(*apiClient)
+
+// WithMiddleware stacks mws on top of the client's RequestHandler, in the
+// order given: mws[0] is applied first and ends up innermost, closest to the
+// underlying RequestHandler, while the last middleware passed ends up
+// outermost and sees the request first.
+func WithMiddleware(mws ...Middleware) ClientOption {
+	return func(c *apiClient) {
+		for _, mw := range mws {
+			c.RequestHandler = mw(c.RequestHandler)
+		}
+	}
+}
+
+// NewLoggingMiddleware returns a Middleware that logs the method, URL,
+// resulting status code (or error) and latency of every request via logger.
+func NewLoggingMiddleware(logger *log.Logger) Middleware {
+	return func(next RequestHandler) RequestHandler {
+		return RequestHandlerFunc(func(r *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.Do(r)
+			if err != nil {
+				logger.Printf("twilio: %s %s failed after %s: %v", r.Method, r.URL, time.Since(start), err)
+				return resp, err
+			}
+
+			logger.Printf("twilio: %s %s -> %d in %s", r.Method, r.URL, resp.StatusCode, time.Since(start))
+			return resp, nil
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Package otelmw provides an OpenTelemetry tracing twilio.Middleware. It is
+// a separate package from twilio so that importing the core REST client
+// does not force every user to take on the OpenTelemetry dependency graph;
+// only callers who want tracing need to import otelmw.
+package otelmw
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/smnalex/twilio-go"
+)
+
+// NewTracingMiddleware returns a twilio.Middleware that wraps every outgoing
+// request in an OpenTelemetry span named "twilio.<METHOD>", recording the
+// request URL, the response status code and any error. A nil tracer uses
+// otel.Tracer("github.com/smnalex/twilio-go").
+func NewTracingMiddleware(tracer trace.Tracer) twilio.Middleware {
+	if tracer == nil {
+		tracer = otel.Tracer("github.com/smnalex/twilio-go")
+	}
+
+	return func(next twilio.RequestHandler) twilio.RequestHandler {
+		return twilio.RequestHandlerFunc(func(r *http.Request) (*http.Response, error) {
+			ctx, span := tracer.Start(r.Context(), "twilio."+r.Method)
+			defer span.End()
+
+			span.SetAttributes(attribute.String("http.url", r.URL.String()))
+
+			resp, err := next.Do(r.WithContext(ctx))
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return resp, err
+			}
+
+			span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+			return resp, nil
+		})
+	}
+}
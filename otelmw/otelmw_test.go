@@ -0,0 +1,34 @@
+package otelmw
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/smnalex/twilio-go"
+)
+
+type stubHandler struct {
+	resp *http.Response
+	err  error
+}
+
+func (s stubHandler) Do(*http.Request) (*http.Response, error) {
+	return s.resp, s.err
+}
+
+func TestNewTracingMiddleware(t *testing.T) {
+	next := stubHandler{resp: &http.Response{StatusCode: 200}}
+	mw := NewTracingMiddleware(nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://twilio.com/v2/get", nil)
+
+	resp, err := mw(next).Do(req)
+	if err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	if resp != next.resp {
+		t.Errorf("exp the wrapped handler's response to pass through unchanged")
+	}
+}
+
+var _ twilio.Middleware = NewTracingMiddleware(nil)
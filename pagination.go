@@ -0,0 +1,142 @@
+package twilio
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// PageMeta mirrors the "meta" block Twilio attaches to every list response.
+type PageMeta struct {
+	Page            int    `json:"page"`
+	PageSize        int    `json:"page_size"`
+	FirstPageURL    string `json:"first_page_url"`
+	PreviousPageURL string `json:"previous_page_url"`
+	NextPageURL     string `json:"next_page_url"`
+	URL             string `json:"url"`
+}
+
+// Page is a single decoded page of list results, alongside Twilio's
+// pagination metadata.
+type Page[T any] struct {
+	Items []T
+	Meta  PageMeta
+}
+
+// PageDecoder unmarshals one list response body into a Page[T]. Each
+// resource supplies its own, since Twilio nests the item array under a
+// resource-specific key (e.g. "services").
+type PageDecoder[T any] func(body []byte) (Page[T], error)
+
+// Iterator lazily walks a paginated Twilio list endpoint, following
+// meta.next_page_url until it is exhausted.
+type Iterator[T any] struct {
+	client   HTTPClient
+	decode   PageDecoder[T]
+	baseURL  string
+	nextPath string
+	done     bool
+	buf      []T
+}
+
+// NewIterator returns an Iterator over path, using client to fetch pages.
+// baseURL must be the same base URL client was constructed with (e.g.
+// "https://chat.twilio.com/v2"): Twilio's meta.next_page_url is an absolute
+// URL that already includes it, so it has to be stripped back off before
+// being re-issued through client.Get, which prepends baseURL itself.
+// pageSize and filter are serialized to a query string on the initial
+// request; decode turns each raw page response into its items and
+// pagination metadata.
+func NewIterator[T any](client HTTPClient, baseURL, path string, pageSize int, filter map[string]string, decode PageDecoder[T]) *Iterator[T] {
+	q := url.Values{}
+	for k, v := range filter {
+		q.Set(k, v)
+	}
+	if pageSize > 0 {
+		q.Set("PageSize", strconv.Itoa(pageSize))
+	}
+	if enc := q.Encode(); enc != "" {
+		path += "?" + enc
+	}
+
+	return &Iterator[T]{client: client, decode: decode, baseURL: baseURL, nextPath: path}
+}
+
+// Next returns the next item across the whole list, fetching additional
+// pages as needed. ok is false once the iterator is exhausted.
+func (it *Iterator[T]) Next(ctx context.Context) (item T, ok bool, err error) {
+	for len(it.buf) == 0 {
+		if it.done {
+			return item, false, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return item, false, err
+		}
+		if err := it.fetchNext(ctx); err != nil {
+			return item, false, err
+		}
+	}
+
+	item, it.buf = it.buf[0], it.buf[1:]
+	return item, true, nil
+}
+
+func (it *Iterator[T]) fetchNext(ctx context.Context) error {
+	body, err := it.client.Get(ctx, it.nextPath)
+	if err != nil {
+		return err
+	}
+
+	page, err := it.decode(body)
+	if err != nil {
+		return err
+	}
+
+	it.buf = page.Items
+	it.nextPath = pathOf(page.Meta.NextPageURL, it.baseURL)
+	if it.nextPath == "" {
+		it.done = true
+	}
+	return nil
+}
+
+// ForEach calls fn for every item in the list, stopping at the first error
+// returned by fn or encountered while paginating.
+func (it *Iterator[T]) ForEach(ctx context.Context, fn func(T) error) error {
+	for {
+		item, ok, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+}
+
+// pathOf strips baseURL from a Twilio pagination URL, keeping only the path
+// and query relative to it, so callers can keep re-issuing requests through
+// an HTTPClient built with that same baseURL. If rawURL doesn't share
+// baseURL's prefix (e.g. baseURL was swapped, or wasn't supplied), it falls
+// back to stripping just the scheme and host.
+func pathOf(rawURL, baseURL string) string {
+	if rawURL == "" {
+		return ""
+	}
+
+	if baseURL != "" {
+		if rest := strings.TrimPrefix(rawURL, strings.TrimSuffix(baseURL, "/")); rest != rawURL {
+			return rest
+		}
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.RequestURI()
+}
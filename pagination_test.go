@@ -0,0 +1,137 @@
+package twilio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type mockListClient struct {
+	GetFunc func(ctx context.Context, path string) ([]byte, error)
+}
+
+func (m *mockListClient) Get(ctx context.Context, path string) ([]byte, error) {
+	return m.GetFunc(ctx, path)
+}
+
+func (m *mockListClient) Post(context.Context, string, io.Reader) ([]byte, error) {
+	return nil, nil
+}
+
+func (m *mockListClient) Delete(context.Context, string) ([]byte, error) {
+	return nil, nil
+}
+
+type listItem struct {
+	Name string `json:"name"`
+}
+
+type listResponse struct {
+	Items []listItem `json:"items"`
+	Meta  PageMeta   `json:"meta"`
+}
+
+func decodeListItemPage(body []byte) (Page[listItem], error) {
+	var resp listResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return Page[listItem]{}, err
+	}
+	return Page[listItem]{Items: resp.Items, Meta: resp.Meta}, nil
+}
+
+func TestIteratorNext(t *testing.T) {
+	var gotPaths []string
+	client := &mockListClient{
+		GetFunc: func(ctx context.Context, path string) ([]byte, error) {
+			gotPaths = append(gotPaths, path)
+
+			if path == "/Items?PageSize=1" {
+				return []byte(`{"items":[{"name":"a"}],"meta":{"next_page_url":"https://twilio.com/v2/Items?PageSize=1&Page=1"}}`), nil
+			}
+			return []byte(`{"items":[{"name":"b"}],"meta":{"next_page_url":""}}`), nil
+		},
+	}
+
+	it := NewIterator[listItem](client, "https://twilio.com/v2", "/Items", 1, nil, decodeListItemPage)
+
+	var got []string
+	for {
+		item, ok, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+		if !ok {
+			break
+		}
+		got = append(got, item.Name)
+	}
+
+	if exp := []string{"a", "b"}; !cmp.Equal(got, exp) {
+		t.Errorf("exp items %v, got %v", exp, got)
+	}
+	if exp := []string{"/Items?PageSize=1", "/Items?PageSize=1&Page=1"}; !cmp.Equal(gotPaths, exp) {
+		t.Errorf("exp paths %v, got %v", exp, gotPaths)
+	}
+}
+
+func TestIteratorForEach(t *testing.T) {
+	client := &mockListClient{
+		GetFunc: func(ctx context.Context, path string) ([]byte, error) {
+			return []byte(`{"items":[{"name":"a"},{"name":"b"}],"meta":{"next_page_url":""}}`), nil
+		},
+	}
+
+	it := NewIterator[listItem](client, "https://twilio.com/v2", "/Items", 0, nil, decodeListItemPage)
+
+	var got []string
+	err := it.ForEach(context.Background(), func(item listItem) error {
+		got = append(got, item.Name)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("exp no err, got %v", err)
+	}
+	if exp := []string{"a", "b"}; !cmp.Equal(got, exp) {
+		t.Errorf("exp items %v, got %v", exp, got)
+	}
+}
+
+func TestIteratorForEachPropagatesCallbackErr(t *testing.T) {
+	client := &mockListClient{
+		GetFunc: func(ctx context.Context, path string) ([]byte, error) {
+			return []byte(`{"items":[{"name":"a"}],"meta":{"next_page_url":""}}`), nil
+		},
+	}
+
+	it := NewIterator[listItem](client, "https://twilio.com/v2", "/Items", 0, nil, decodeListItemPage)
+
+	wantErr := errors.New("stop")
+	err := it.ForEach(context.Background(), func(item listItem) error {
+		return wantErr
+	})
+	if err != wantErr {
+		t.Errorf("exp %v, got %v", wantErr, err)
+	}
+}
+
+func TestIteratorNextRespectsCancelledContext(t *testing.T) {
+	client := &mockListClient{
+		GetFunc: func(ctx context.Context, path string) ([]byte, error) {
+			t.Fatal("exp Get not to be invoked once ctx is done")
+			return nil, nil
+		},
+	}
+
+	it := NewIterator[listItem](client, "https://twilio.com/v2", "/Items", 0, nil, decodeListItemPage)
+
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, _, err := it.Next(cancelCtx); err != context.Canceled {
+		t.Errorf("exp context.Canceled, got %v", err)
+	}
+}
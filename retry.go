@@ -0,0 +1,160 @@
+package twilio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type idempotentCtxKey struct{}
+
+// WithIdempotent marks the request carried by ctx as safe to retry even
+// though its HTTP method (typically POST) is not automatically considered
+// idempotent by RetryPolicy. Use it when the caller knows a retry is safe,
+// e.g. the request is guarded by an idempotency key.
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentCtxKey{}, true)
+}
+
+func isIdempotentCtx(ctx context.Context) bool {
+	v, _ := ctx.Value(idempotentCtxKey{}).(bool)
+	return v
+}
+
+// RetryPolicy configures automatic retries of transient failures (429s, 5xxs
+// and network errors) with exponential backoff. The delay before attempt N
+// (0-indexed, N > 0) is min(MaxDelay, BaseDelay*2^(N-1)) plus a random jitter
+// in [0, Jitter), unless the response carries a Retry-After header, in which
+// case that value is used instead.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values <= 1 disable retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff, before jitter is added. Zero means
+	// no cap.
+	MaxDelay time.Duration
+	// Jitter bounds an additional random delay applied to every backoff, to
+	// avoid many clients retrying in lockstep.
+	Jitter time.Duration
+	// RetryableStatus is the set of HTTP status codes that trigger a retry.
+	// Defaults to {429, 500, 502, 503, 504} when nil.
+	RetryableStatus map[int]bool
+	// RetryableMethods is the set of HTTP methods that may be retried
+	// without per-request opt-in. Defaults to {GET, HEAD, DELETE} when nil -
+	// POST is only retried if the caller opts in via WithIdempotent.
+	RetryableMethods map[string]bool
+	// OnRetry, when set, is called before sleeping ahead of each retry.
+	OnRetry func(attempt int, err error, nextDelay time.Duration)
+}
+
+// WithRetryPolicy installs policy on the client so apiClient.request retries
+// transient failures instead of surfacing them immediately.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *apiClient) {
+		c.retry = &policy
+	}
+}
+
+var defaultRetryableStatus = map[int]bool{
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+	http.StatusGatewayTimeout:      true,
+}
+
+var defaultRetryableMethods = map[string]bool{
+	http.MethodGet:    true,
+	http.MethodHead:   true,
+	http.MethodDelete: true,
+}
+
+func (p *RetryPolicy) retryableStatus(code int) bool {
+	if p.RetryableStatus == nil {
+		return defaultRetryableStatus[code]
+	}
+	return p.RetryableStatus[code]
+}
+
+func (p *RetryPolicy) retryableMethod(ctx context.Context, method string) bool {
+	if isIdempotentCtx(ctx) {
+		return true
+	}
+	if p.RetryableMethods == nil {
+		return defaultRetryableMethods[method]
+	}
+	return p.RetryableMethods[method]
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := time.Duration(float64(p.BaseDelay) * math.Pow(2, float64(attempt)))
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		delay += time.Duration(rand.Float64() * float64(p.Jitter))
+	}
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header value, either delta-seconds or
+// an HTTP-date, per RFC 7231 §7.1.3.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
+
+// sleepCtx sleeps for d, returning ctx.Err() if ctx is done first.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// bodyReplayer buffers body so it can be read more than once across retry
+// attempts. If body already implements io.ReadSeeker it is rewound in place
+// rather than copied; otherwise it is read into memory up front.
+func bodyReplayer(body io.Reader) (func() io.Reader, error) {
+	if body == nil {
+		return func() io.Reader { return nil }, nil
+	}
+
+	if seeker, ok := body.(io.ReadSeeker); ok {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		return func() io.Reader {
+			seeker.Seek(start, io.SeekStart)
+			return seeker
+		}, nil
+	}
+
+	buf, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	return func() io.Reader { return bytes.NewReader(buf) }, nil
+}
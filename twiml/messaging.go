@@ -0,0 +1,91 @@
+package twiml
+
+import "encoding/xml"
+
+// messagingVerb is implemented by every verb that may appear directly inside
+// a <Response> returned by NewMessagingResponse.
+type messagingVerb interface {
+	isMessagingVerb()
+}
+
+// Media attaches a media file to a <Message>.
+type Media struct {
+	XMLName xml.Name `xml:"Media"`
+	URL     string   `xml:",chardata"`
+}
+
+// MessageOptions configures a <Message> verb.
+type MessageOptions struct {
+	To   string
+	From string
+}
+
+// Message sends an SMS/MMS, optionally with media attachments.
+type Message struct {
+	XMLName xml.Name `xml:"Message"`
+	To      string   `xml:"to,attr,omitempty"`
+	From    string   `xml:"from,attr,omitempty"`
+	Body    string   `xml:"Body,omitempty"`
+	Media   []Media  `xml:"Media,omitempty"`
+}
+
+func (Message) isMessagingVerb() {}
+
+// Redirect transfers control of the message to the TwiML at URL.
+type Redirect struct {
+	XMLName xml.Name `xml:"Redirect"`
+	Method  string   `xml:"method,attr,omitempty"`
+	URL     string   `xml:",chardata"`
+}
+
+func (Redirect) isMessagingVerb() {}
+
+// MessagingResponse builds a TwiML <Response> document for a messaging
+// webhook.
+type MessagingResponse struct {
+	verbs []messagingVerb
+}
+
+// NewMessagingResponse starts building an empty MessagingResponse.
+func NewMessagingResponse() *MessagingResponse {
+	return &MessagingResponse{}
+}
+
+// Message appends a <Message> verb with the given body and any media
+// attachments.
+func (r *MessagingResponse) Message(body string, opts MessageOptions, mediaURLs ...string) *MessagingResponse {
+	msg := Message{To: opts.To, From: opts.From, Body: body}
+	for _, u := range mediaURLs {
+		msg.Media = append(msg.Media, Media{URL: u})
+	}
+	r.verbs = append(r.verbs, msg)
+	return r
+}
+
+// Redirect appends a <Redirect> verb.
+func (r *MessagingResponse) Redirect(url, method string) *MessagingResponse {
+	r.verbs = append(r.verbs, Redirect{Method: method, URL: url})
+	return r
+}
+
+// MarshalXML encodes r as <Response>verbs...</Response>, since its verbs are
+// a heterogeneous slice that encoding/xml cannot marshal automatically.
+func (r MessagingResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "Response"}
+	start.Attr = nil
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.verbs {
+		if err := e.Encode(v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// Build marshals r as a complete TwiML XML document.
+func (r *MessagingResponse) Build() ([]byte, error) {
+	return marshalDocument(r)
+}
@@ -0,0 +1,36 @@
+package twiml
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMessagingResponseBuild(t *testing.T) {
+	t.Run("message with media", func(t *testing.T) {
+		got, err := NewMessagingResponse().
+			Message("check this out", MessageOptions{To: "+15551234567", From: "+15557654321"}, "https://example.com/a.jpg").
+			Build()
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		exp := xmlHeader + `<Response><Message to="+15551234567" from="+15557654321">` +
+			`<Body>check this out</Body><Media>https://example.com/a.jpg</Media></Message></Response>`
+		if !cmp.Equal(string(got), exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("redirect", func(t *testing.T) {
+		got, err := NewMessagingResponse().Redirect("/next", "POST").Build()
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		exp := xmlHeader + `<Response><Redirect method="POST">/next</Redirect></Response>`
+		if !cmp.Equal(string(got), exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	})
+}
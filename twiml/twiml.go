@@ -0,0 +1,40 @@
+// Package twiml provides a fluent builder for TwiML, the XML markup Twilio
+// expects voice and messaging webhooks to respond with. See
+// https://www.twilio.com/docs/voice/twiml and
+// https://www.twilio.com/docs/sms/twiml.
+package twiml
+
+import (
+	"encoding/xml"
+	"net/http"
+)
+
+// Response is implemented by *VoiceResponse and *MessagingResponse, the two
+// TwiML root documents Twilio accepts.
+type Response interface {
+	Build() ([]byte, error)
+}
+
+// WriteResponse marshals r and writes it to w as a complete TwiML document,
+// setting Content-Type to application/xml. Marshal errors are written as a
+// 500 response instead of a TwiML body.
+func WriteResponse(w http.ResponseWriter, r Response) {
+	body, err := r.Build()
+	if err != nil {
+		http.Error(w, "twiml: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.Write(body)
+}
+
+// marshalDocument wraps body (the marshaled <Response> element) with the XML
+// declaration Twilio requires.
+func marshalDocument(root interface{}) ([]byte, error) {
+	body, err := xml.Marshal(root)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
@@ -0,0 +1,44 @@
+package twiml
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var xmlHeader = xml.Header
+
+func TestWriteResponse(t *testing.T) {
+	t.Run("writes a twiml document with the right content type", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		WriteResponse(rec, NewMessagingResponse().Message("hi", MessageOptions{}))
+
+		if exp := "application/xml"; rec.Header().Get("Content-Type") != exp {
+			t.Errorf("exp Content-Type %s, got %s", exp, rec.Header().Get("Content-Type"))
+		}
+
+		exp := xmlHeader + `<Response><Message><Body>hi</Body></Message></Response>`
+		if got := rec.Body.String(); got != exp {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("surfaces marshal errors as a 500", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		WriteResponse(rec, brokenResponse{})
+
+		if exp := http.StatusInternalServerError; rec.Code != exp {
+			t.Errorf("exp status %d, got %d", exp, rec.Code)
+		}
+	})
+}
+
+type brokenResponse struct{}
+
+func (brokenResponse) Build() ([]byte, error) {
+	return nil, errMarshalBroken
+}
+
+var errMarshalBroken = errors.New("broken")
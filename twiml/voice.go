@@ -0,0 +1,216 @@
+package twiml
+
+import (
+	"encoding/xml"
+	"strconv"
+)
+
+// voiceVerb is implemented by every verb that may appear directly inside a
+// <Response> returned by NewVoiceResponse.
+type voiceVerb interface {
+	isVoiceVerb()
+}
+
+// gatherChild is implemented by every verb that may appear nested inside a
+// <Gather>. Twilio only allows <Say> and <Play> there.
+type gatherChild interface {
+	isGatherChild()
+}
+
+// SayOptions configures a <Say> verb.
+type SayOptions struct {
+	Voice    string
+	Language string
+}
+
+// Say speaks text to the caller, e.g. with Voice: "Polly.Joanna".
+type Say struct {
+	XMLName  xml.Name `xml:"Say"`
+	Voice    string   `xml:"voice,attr,omitempty"`
+	Language string   `xml:"language,attr,omitempty"`
+	Text     string   `xml:",chardata"`
+}
+
+func (Say) isVoiceVerb()   {}
+func (Say) isGatherChild() {}
+
+// PlayOptions configures a <Play> verb.
+type PlayOptions struct {
+	// Loop is the number of times to play the audio. Zero means the Twilio
+	// default (once).
+	Loop int
+}
+
+// Play plays an audio file located at URL to the caller.
+type Play struct {
+	XMLName xml.Name `xml:"Play"`
+	Loop    int      `xml:"loop,attr,omitempty"`
+	URL     string   `xml:",chardata"`
+}
+
+func (Play) isVoiceVerb()   {}
+func (Play) isGatherChild() {}
+
+// DialOptions configures a <Dial> verb.
+type DialOptions struct {
+	Action  string
+	Method  string
+	Timeout int
+}
+
+// Dial connects the caller to number.
+type Dial struct {
+	XMLName xml.Name `xml:"Dial"`
+	Action  string   `xml:"action,attr,omitempty"`
+	Method  string   `xml:"method,attr,omitempty"`
+	Timeout int      `xml:"timeout,attr,omitempty"`
+	Number  string   `xml:",chardata"`
+}
+
+func (Dial) isVoiceVerb() {}
+
+// Hangup ends the call.
+type Hangup struct {
+	XMLName xml.Name `xml:"Hangup"`
+}
+
+func (Hangup) isVoiceVerb() {}
+
+// GatherOptions configures a <Gather> verb.
+type GatherOptions struct {
+	Action    string
+	Method    string
+	NumDigits int
+	Timeout   int
+}
+
+// Gather collects digits or speech from the caller, optionally prompting
+// with nested <Say>/<Play> verbs. Build a Gather with NewGather so that only
+// valid children can be added.
+type Gather struct {
+	opts     GatherOptions
+	children []gatherChild
+}
+
+func (Gather) isVoiceVerb() {}
+
+// MarshalXML encodes g as <Gather ...>children...</Gather>, since its
+// children are a heterogeneous slice that encoding/xml cannot marshal
+// automatically.
+func (g Gather) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "Gather"}
+	start.Attr = nil
+
+	if g.opts.Action != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "action"}, Value: g.opts.Action})
+	}
+	if g.opts.Method != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "method"}, Value: g.opts.Method})
+	}
+	if g.opts.NumDigits != 0 {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "numDigits"}, Value: strconv.Itoa(g.opts.NumDigits)})
+	}
+	if g.opts.Timeout != 0 {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "timeout"}, Value: strconv.Itoa(g.opts.Timeout)})
+	}
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, c := range g.children {
+		if err := e.Encode(c); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// GatherBuilder builds a Gather verb, restricting its children to the verbs
+// Twilio allows inside <Gather>.
+type GatherBuilder struct {
+	opts     GatherOptions
+	children []gatherChild
+}
+
+// NewGather starts building a <Gather> verb configured by opts.
+func NewGather(opts GatherOptions) *GatherBuilder {
+	return &GatherBuilder{opts: opts}
+}
+
+// Say appends a <Say> prompt inside the <Gather>.
+func (g *GatherBuilder) Say(text string, opts SayOptions) *GatherBuilder {
+	g.children = append(g.children, Say{Voice: opts.Voice, Language: opts.Language, Text: text})
+	return g
+}
+
+// Play appends a <Play> prompt inside the <Gather>.
+func (g *GatherBuilder) Play(url string, opts PlayOptions) *GatherBuilder {
+	g.children = append(g.children, Play{Loop: opts.Loop, URL: url})
+	return g
+}
+
+func (g *GatherBuilder) build() Gather {
+	return Gather{opts: g.opts, children: g.children}
+}
+
+// VoiceResponse builds a TwiML <Response> document for a voice webhook.
+type VoiceResponse struct {
+	verbs []voiceVerb
+}
+
+// NewVoiceResponse starts building an empty VoiceResponse.
+func NewVoiceResponse() *VoiceResponse {
+	return &VoiceResponse{}
+}
+
+// Say appends a <Say> verb.
+func (r *VoiceResponse) Say(text string, opts SayOptions) *VoiceResponse {
+	r.verbs = append(r.verbs, Say{Voice: opts.Voice, Language: opts.Language, Text: text})
+	return r
+}
+
+// Play appends a <Play> verb.
+func (r *VoiceResponse) Play(url string, opts PlayOptions) *VoiceResponse {
+	r.verbs = append(r.verbs, Play{Loop: opts.Loop, URL: url})
+	return r
+}
+
+// Dial appends a <Dial> verb.
+func (r *VoiceResponse) Dial(number string, opts DialOptions) *VoiceResponse {
+	r.verbs = append(r.verbs, Dial{Action: opts.Action, Method: opts.Method, Timeout: opts.Timeout, Number: number})
+	return r
+}
+
+// Gather appends a <Gather> verb built with g.
+func (r *VoiceResponse) Gather(g *GatherBuilder) *VoiceResponse {
+	r.verbs = append(r.verbs, g.build())
+	return r
+}
+
+// Hangup appends a <Hangup> verb.
+func (r *VoiceResponse) Hangup() *VoiceResponse {
+	r.verbs = append(r.verbs, Hangup{})
+	return r
+}
+
+// MarshalXML encodes r as <Response>verbs...</Response>, since its verbs are
+// a heterogeneous slice that encoding/xml cannot marshal automatically.
+func (r VoiceResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "Response"}
+	start.Attr = nil
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.verbs {
+		if err := e.Encode(v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// Build marshals r as a complete TwiML XML document.
+func (r *VoiceResponse) Build() ([]byte, error) {
+	return marshalDocument(r)
+}
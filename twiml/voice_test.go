@@ -0,0 +1,67 @@
+package twiml
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestVoiceResponseBuild(t *testing.T) {
+	t.Run("say", func(t *testing.T) {
+		got, err := NewVoiceResponse().
+			Say("hello", SayOptions{Voice: "Polly.Joanna", Language: "en-US"}).
+			Build()
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		exp := xmlHeader + `<Response><Say voice="Polly.Joanna" language="en-US">hello</Say></Response>`
+		if !cmp.Equal(string(got), exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("dial then hangup", func(t *testing.T) {
+		got, err := NewVoiceResponse().
+			Dial("+15551234567", DialOptions{Action: "/dial-complete"}).
+			Hangup().
+			Build()
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		exp := xmlHeader + `<Response><Dial action="/dial-complete">+15551234567</Dial><Hangup></Hangup></Response>`
+		if !cmp.Equal(string(got), exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("gather with nested say and play", func(t *testing.T) {
+		gather := NewGather(GatherOptions{NumDigits: 1, Action: "/gather"}).
+			Say("press a key", SayOptions{}).
+			Play("https://example.com/prompt.mp3", PlayOptions{})
+
+		got, err := NewVoiceResponse().Gather(gather).Build()
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		exp := xmlHeader + `<Response><Gather action="/gather" numDigits="1">` +
+			`<Say>press a key</Say><Play>https://example.com/prompt.mp3</Play></Gather></Response>`
+		if !cmp.Equal(string(got), exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("empty response", func(t *testing.T) {
+		got, err := NewVoiceResponse().Build()
+		if err != nil {
+			t.Fatalf("exp no err, got %v", err)
+		}
+
+		exp := xmlHeader + `<Response></Response>`
+		if !cmp.Equal(string(got), exp) {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	})
+}
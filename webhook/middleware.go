@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// Middleware returns an http.Handler that validates the X-Twilio-Signature
+// header on every request before delegating to next, using authToken to
+// recompute the expected signature. Requests that are unsigned or fail
+// validation are rejected with 403 Forbidden. The signed URL is always
+// reconstructed from the request itself, as by a Validator without
+// ForwardedHeaders: X-Forwarded-Proto and X-Forwarded-Host are ignored.
+func Middleware(authToken string, next http.Handler) http.Handler {
+	return newMiddleware(Validator{}, authToken, next)
+}
+
+func newMiddleware(v Validator, authToken string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature := r.Header.Get("X-Twilio-Signature")
+		if signature == "" {
+			http.Error(w, "webhook: missing X-Twilio-Signature header", http.StatusForbidden)
+			return
+		}
+
+		reqURL := v.RequestURL(r)
+
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, "webhook: could not read request body", http.StatusForbidden)
+				return
+			}
+			r.Body.Close()
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+			if !v.ValidateJSON(authToken, reqURL, body, signature) {
+				http.Error(w, "webhook: invalid signature", http.StatusForbidden)
+				return
+			}
+		} else {
+			if err := r.ParseForm(); err != nil {
+				http.Error(w, "webhook: could not parse form body", http.StatusForbidden)
+				return
+			}
+			if !v.Validate(authToken, reqURL, r.PostForm, signature) {
+				http.Error(w, "webhook: invalid signature", http.StatusForbidden)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,125 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func signForm(reqURL string, params url.Values) string {
+	var b strings.Builder
+	b.WriteString(reqURL)
+	for _, k := range []string{"Body", "From"} {
+		if v, ok := params[k]; ok {
+			b.WriteString(k)
+			b.WriteString(v[0])
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(b.String()))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestMiddleware(t *testing.T) {
+	var handlerCalled bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("valid signature passes through", func(t *testing.T) {
+		handlerCalled = false
+
+		form := url.Values{"Body": {"hello"}, "From": {"+15551234567"}}
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/sms", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("X-Twilio-Signature", signForm("https://example.com/sms", form))
+
+		rec := httptest.NewRecorder()
+		Middleware(authToken, next).ServeHTTP(rec, req)
+
+		if !handlerCalled {
+			t.Error("exp next handler to be called")
+		}
+		if exp := http.StatusOK; rec.Code != exp {
+			t.Errorf("exp status %d, got %d", exp, rec.Code)
+		}
+	})
+
+	t.Run("missing signature is rejected", func(t *testing.T) {
+		handlerCalled = false
+
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/sms", nil)
+		rec := httptest.NewRecorder()
+		Middleware(authToken, next).ServeHTTP(rec, req)
+
+		if handlerCalled {
+			t.Error("exp next handler not to be called")
+		}
+		if exp := http.StatusForbidden; rec.Code != exp {
+			t.Errorf("exp status %d, got %d", exp, rec.Code)
+		}
+	})
+
+	t.Run("invalid signature is rejected", func(t *testing.T) {
+		handlerCalled = false
+
+		form := url.Values{"Body": {"hello"}, "From": {"+15551234567"}}
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/sms", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("X-Twilio-Signature", "bogus")
+
+		rec := httptest.NewRecorder()
+		Middleware(authToken, next).ServeHTTP(rec, req)
+
+		if handlerCalled {
+			t.Error("exp next handler not to be called")
+		}
+		if exp := http.StatusForbidden; rec.Code != exp {
+			t.Errorf("exp status %d, got %d", exp, rec.Code)
+		}
+	})
+
+	t.Run("json body is re-readable by next handler", func(t *testing.T) {
+		var gotBody []byte
+		jsonNext := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotBody, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusOK)
+		})
+
+		body := `{"hello":"world"}`
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/flex", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Twilio-Signature", jsonSignature(t, "https://example.com/flex", []byte(body)))
+
+		rec := httptest.NewRecorder()
+		Middleware(authToken, jsonNext).ServeHTTP(rec, req)
+
+		if exp := http.StatusOK; rec.Code != exp {
+			t.Fatalf("exp status %d, got %d", exp, rec.Code)
+		}
+		if exp := body; string(gotBody) != exp {
+			t.Errorf("exp body %s, got %s", exp, gotBody)
+		}
+	})
+}
+
+func jsonSignature(t *testing.T, reqURL string, body []byte) string {
+	t.Helper()
+
+	sum := sha256.Sum256(body)
+	signedURL := reqURL + "?bodySHA256=" + hex.EncodeToString(sum[:])
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(signedURL))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,97 @@
+// Package webhook validates that incoming HTTP requests were actually sent
+// by Twilio, per https://www.twilio.com/docs/usage/security#validating-requests.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Validator checks the X-Twilio-Signature header Twilio attaches to every
+// webhook request.
+type Validator struct {
+	// ForwardedHeaders, when true, reconstructs the signed URL from
+	// X-Forwarded-Proto / X-Forwarded-Host instead of the request itself.
+	// Set this when the receiver sits behind a reverse proxy or load
+	// balancer that terminates TLS or rewrites the Host header.
+	ForwardedHeaders bool
+}
+
+// Validate reports whether signature is the HMAC-SHA1 Twilio computed for a
+// POST to reqURL with form body params.
+func (v Validator) Validate(authToken, reqURL string, params url.Values, signature string) bool {
+	want, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(want, v.sign(authToken, reqURL, params))
+}
+
+// ValidateJSON is like Validate, but for JSON-bodied webhooks (e.g.
+// Flex/Studio): body's SHA-256 hash is appended to reqURL as a bodySHA256
+// query parameter before signing, since there are no form params to sign.
+func (v Validator) ValidateJSON(authToken, reqURL string, body []byte, signature string) bool {
+	sum := sha256.Sum256(body)
+	signedURL := reqURL + sep(reqURL) + "bodySHA256=" + hex.EncodeToString(sum[:])
+	return v.Validate(authToken, signedURL, nil, signature)
+}
+
+// sign computes the raw HMAC-SHA1 Twilio uses to sign reqURL and params: the
+// URL followed by each form key and value, in lexicographic key order, with
+// no separators.
+func (v Validator) sign(authToken, reqURL string, params url.Values) []byte {
+	var b strings.Builder
+	b.WriteString(reqURL)
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		b.WriteString(k)
+		b.WriteString(params.Get(k))
+	}
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(b.String()))
+	return mac.Sum(nil)
+}
+
+func sep(reqURL string) string {
+	if strings.Contains(reqURL, "?") {
+		return "&"
+	}
+	return "?"
+}
+
+// RequestURL reconstructs the absolute URL Twilio would have signed for r.
+// When v.ForwardedHeaders is set, X-Forwarded-Proto and X-Forwarded-Host
+// take precedence over r.TLS and r.Host.
+func (v Validator) RequestURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	host := r.Host
+
+	if v.ForwardedHeaders {
+		if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+			scheme = proto
+		}
+		if fwdHost := r.Header.Get("X-Forwarded-Host"); fwdHost != "" {
+			host = fwdHost
+		}
+	}
+
+	return fmt.Sprintf("%s://%s%s", scheme, host, r.URL.RequestURI())
+}
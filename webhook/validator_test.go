@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const authToken = "12345"
+
+func sign(t *testing.T, reqURL string, params url.Values) string {
+	t.Helper()
+
+	var b strings.Builder
+	b.WriteString(reqURL)
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+
+	// deliberately not pre-sorted: sign params by hand in a fixed order
+	// known to be lexicographic for the fixtures used below.
+	for _, k := range []string{"Body", "From", "To"} {
+		if v, ok := params[k]; ok {
+			b.WriteString(k)
+			b.WriteString(v[0])
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(b.String()))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestValidatorValidate(t *testing.T) {
+	reqURL := "https://example.com/webhook"
+	params := url.Values{
+		"Body": {"hello"},
+		"From": {"+15551234567"},
+		"To":   {"+15557654321"},
+	}
+
+	t.Run("correct signature", func(t *testing.T) {
+		v := Validator{}
+		sig := sign(t, reqURL, params)
+		if !v.Validate(authToken, reqURL, params, sig) {
+			t.Error("exp valid signature, got invalid")
+		}
+	})
+
+	t.Run("tampered param", func(t *testing.T) {
+		v := Validator{}
+		sig := sign(t, reqURL, params)
+		tampered := url.Values{"Body": {"goodbye"}, "From": params["From"], "To": params["To"]}
+		if v.Validate(authToken, reqURL, tampered, sig) {
+			t.Error("exp invalid signature, got valid")
+		}
+	})
+
+	t.Run("wrong auth token", func(t *testing.T) {
+		v := Validator{}
+		sig := sign(t, reqURL, params)
+		if v.Validate("wrong-token", reqURL, params, sig) {
+			t.Error("exp invalid signature, got valid")
+		}
+	})
+
+	t.Run("malformed base64 signature", func(t *testing.T) {
+		v := Validator{}
+		if v.Validate(authToken, reqURL, params, "not-base64!!") {
+			t.Error("exp invalid signature, got valid")
+		}
+	})
+}
+
+func TestValidatorValidateJSON(t *testing.T) {
+	reqURL := "https://example.com/webhook"
+	body := []byte(`{"hello":"world"}`)
+
+	sum := sha256.Sum256(body)
+	signedURL := reqURL + "?bodySHA256=" + hex.EncodeToString(sum[:])
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(signedURL))
+	sig := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	v := Validator{}
+	if !v.ValidateJSON(authToken, reqURL, body, sig) {
+		t.Error("exp valid signature, got invalid")
+	}
+	if v.ValidateJSON(authToken, reqURL, []byte(`{"tampered":true}`), sig) {
+		t.Error("exp invalid signature, got valid")
+	}
+}
+
+func TestValidatorRequestURL(t *testing.T) {
+	t.Run("direct request", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "https://example.com/webhook?a=1", nil)
+
+		v := Validator{}
+		if exp, got := "https://example.com/webhook?a=1", v.RequestURL(r); exp != got {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("behind a reverse proxy", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "http://internal.local/webhook", nil)
+		r.Header.Set("X-Forwarded-Proto", "https")
+		r.Header.Set("X-Forwarded-Host", "public.example.com")
+
+		v := Validator{ForwardedHeaders: true}
+		if exp, got := "https://public.example.com/webhook", v.RequestURL(r); exp != got {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	})
+
+	t.Run("forwarded headers ignored unless opted in", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "http://internal.local/webhook", nil)
+		r.Header.Set("X-Forwarded-Proto", "https")
+		r.Header.Set("X-Forwarded-Host", "public.example.com")
+
+		v := Validator{}
+		if exp, got := "http://internal.local/webhook", v.RequestURL(r); exp != got {
+			t.Errorf("exp %s, got %s", exp, got)
+		}
+	})
+}